@@ -36,6 +36,8 @@ func DefaultOptions() Options {
 	return Options{
 		Logger:                  logging.NewNopLogger(),
 		GlobalRateLimiter:       ratelimiter.NewGlobal(1),
+		BaseBackoff:             1 * time.Second,
+		MaxBackoff:              60 * time.Second,
 		PollInterval:            1 * time.Minute,
 		MaxConcurrentReconciles: 1,
 		Features:                &feature.Flags{},
@@ -51,6 +53,16 @@ type Options struct {
 	// reconciles across all controllers will be subject to this limit.
 	GlobalRateLimiter ratelimiter.RateLimiter
 
+	// BaseBackoff is how long each controller waits before retrying a
+	// resource that failed to reconcile. The delay doubles with each
+	// consecutive failure, up to MaxBackoff. Defaults to one second if not
+	// set, and is clamped to MaxBackoff if greater.
+	BaseBackoff time.Duration
+
+	// MaxBackoff is the longest each controller waits before retrying a
+	// resource that failed to reconcile. Defaults to 60 seconds if not set.
+	MaxBackoff time.Duration
+
 	// PollInterval at which each controller should speculatively poll to
 	// determine whether it has work to do.
 	PollInterval time.Duration
@@ -80,7 +92,7 @@ func (o Options) ForControllerRuntime() controller.Options {
 
 	return controller.Options{
 		MaxConcurrentReconciles: o.MaxConcurrentReconciles,
-		RateLimiter:             ratelimiter.NewController(),
+		RateLimiter:             ratelimiter.NewControllerWithBackoff(o.BaseBackoff, o.MaxBackoff),
 		RecoverPanic:            &recoverPanic,
 	}
 }
@@ -26,6 +26,11 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 )
 
+const (
+	defaultBaseDelay = 1 * time.Second
+	defaultMaxDelay  = 60 * time.Second
+)
+
 // NewGlobal returns a token bucket rate limiter meant for limiting the number
 // of average total requeues per second for all controllers registered with a
 // controller manager. The bucket size (i.e. allowed burst) is rps * 10.
@@ -40,7 +45,28 @@ type ControllerRateLimiter = workqueue.TypedRateLimiter[reconcile.Request]
 // passed rate limiter and a per-item exponential backoff limiter. The
 // exponential backoff limiter has a base delay of 1s and a maximum of 60s.
 func NewController() ControllerRateLimiter {
-	return workqueue.NewTypedItemExponentialFailureRateLimiter[reconcile.Request](1*time.Second, 60*time.Second)
+	return NewControllerWithBackoff(defaultBaseDelay, defaultMaxDelay)
+}
+
+// NewControllerWithBackoff returns a per-item exponential backoff rate limiter
+// that waits base before retrying an item's first failure, doubling the delay
+// with each subsequent failure up to maxDelay. A base or maxDelay that is not
+// positive defaults to 1s or 60s respectively. A base greater than maxDelay is
+// clamped to maxDelay.
+func NewControllerWithBackoff(base, maxDelay time.Duration) ControllerRateLimiter {
+	if base <= 0 {
+		base = defaultBaseDelay
+	}
+
+	if maxDelay <= 0 {
+		maxDelay = defaultMaxDelay
+	}
+
+	if base > maxDelay {
+		base = maxDelay
+	}
+
+	return workqueue.NewTypedItemExponentialFailureRateLimiter[reconcile.Request](base, maxDelay)
 }
 
 // LimitRESTConfig returns a copy of the supplied REST config with rate limits
@@ -0,0 +1,76 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ratelimiter
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+func TestNewControllerWithBackoff(t *testing.T) {
+	type args struct {
+		base     time.Duration
+		maxDelay time.Duration
+	}
+
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   []time.Duration
+	}{
+		"Backoff": {
+			reason: "The first failure should wait base, doubling with each subsequent failure up to maxDelay.",
+			args:   args{base: 1 * time.Second, maxDelay: 10 * time.Second},
+			want:   []time.Duration{1 * time.Second, 2 * time.Second, 4 * time.Second, 8 * time.Second, 10 * time.Second, 10 * time.Second},
+		},
+		"DefaultBase": {
+			reason: "A base that is not set should default to 1s without ignoring maxDelay.",
+			args:   args{maxDelay: 3 * time.Second},
+			want:   []time.Duration{1 * time.Second, 2 * time.Second, 3 * time.Second},
+		},
+		"DefaultMaxDelay": {
+			reason: "A maxDelay that is not set should default to 60s without ignoring base.",
+			args:   args{base: 20 * time.Second},
+			want:   []time.Duration{20 * time.Second, 40 * time.Second, 60 * time.Second, 60 * time.Second},
+		},
+		"BaseGreaterThanMaxDelay": {
+			reason: "A base greater than maxDelay should be clamped to maxDelay.",
+			args:   args{base: 10 * time.Second, maxDelay: 5 * time.Second},
+			want:   []time.Duration{5 * time.Second, 5 * time.Second},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			rl := NewControllerWithBackoff(tc.args.base, tc.args.maxDelay)
+			item := reconcile.Request{NamespacedName: types.NamespacedName{Name: "cool"}}
+
+			got := make([]time.Duration, 0, len(tc.want))
+			for range tc.want {
+				got = append(got, rl.When(item))
+			}
+
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\nWhen(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
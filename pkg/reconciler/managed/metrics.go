@@ -30,6 +30,69 @@ import (
 
 const subSystem = "crossplane"
 
+// Reasons a reconcile intentionally skipped operations on the external
+// resource.
+const (
+	skipReasonPaused           = "paused"
+	skipReasonReadOnly         = "read-only"
+	skipReasonObserveOnly      = "observe-only"
+	skipReasonUpdateNotAllowed = "update-not-allowed"
+)
+
+// A reconcilePhase is a phase of a reconcile whose latency is recorded.
+type reconcilePhase string
+
+// Reconcile phases.
+const (
+	phaseGet        reconcilePhase = "get"
+	phaseInitialize reconcilePhase = "initialize"
+	phaseConnect    reconcilePhase = "connect"
+	phaseObserve    reconcilePhase = "observe"
+	phaseMutate     reconcilePhase = "mutate"
+	phaseWriteBack  reconcilePhase = "write-back"
+	phaseDisconnect reconcilePhase = "disconnect"
+)
+
+// reconcilePhases in the order they happen during a reconcile.
+var reconcilePhases = []reconcilePhase{phaseGet, phaseInitialize, phaseConnect, phaseObserve, phaseMutate, phaseWriteBack, phaseDisconnect}
+
+// A phaseTimer breaks down the latency of a reconcile by phase.
+type phaseTimer struct {
+	now       func() time.Time
+	last      time.Time
+	durations map[reconcilePhase]time.Duration
+}
+
+func newPhaseTimer() *phaseTimer {
+	return &phaseTimer{now: time.Now, last: time.Now(), durations: make(map[reconcilePhase]time.Duration)}
+}
+
+// done attributes the time elapsed since done was last called to the supplied
+// phase. A phase may be done more than once, in which case its durations are
+// summed.
+func (t *phaseTimer) done(p reconcilePhase) {
+	now := t.now()
+	t.durations[p] += now.Sub(t.last)
+	t.last = now
+}
+
+// keysAndValues returns the duration of each phase that happened, suitable for
+// structured logging.
+func (t *phaseTimer) keysAndValues() []any {
+	kv := make([]any, 0, len(t.durations)*2)
+
+	for _, p := range reconcilePhases {
+		d, ok := t.durations[p]
+		if !ok {
+			continue
+		}
+
+		kv = append(kv, string(p), d.String())
+	}
+
+	return kv
+}
+
 // MetricRecorder records the managed resource metrics.
 type MetricRecorder interface { //nolint:interfacebloat // The first two methods are coming from Prometheus
 	Describe(ch chan<- *prometheus.Desc)
@@ -39,18 +102,45 @@ type MetricRecorder interface { //nolint:interfacebloat // The first two methods
 	recordFirstTimeReconciled(managed resource.Managed)
 	recordFirstTimeReady(managed resource.Managed)
 	recordDrift(managed resource.Managed)
+	recordUpToDate(managed resource.Managed)
+	recordNotUpToDate(managed resource.Managed)
 	recordDeleted(managed resource.Managed)
+	recordSkipped(managed resource.Managed, reason string)
+	recordPhases(managed resource.Managed, t *phaseTimer)
+}
+
+// A drift records when a managed resource of a GVK was first observed not to be
+// up to date.
+type drift struct {
+	gvk   string
+	since time.Time
 }
 
 // MRMetricRecorder records the lifecycle metrics of managed resources.
 type MRMetricRecorder struct {
 	firstObservation sync.Map
 	lastObservation  sync.Map
+	// driftObservation tracks when each managed resource, keyed by UID, was
+	// first observed not to be up to date. Entries are removed when the
+	// resource is observed to be up to date again or is deleted through the
+	// reconciler. A managed resource that disappears any other way, for
+	// example because its finalizer was removed externally, is never removed
+	// and continues to count towards the not up to date metrics until the
+	// provider restarts.
+	driftObservation sync.Map
+
+	// driftMu serialises computing the oldest drift gauge at collect time.
+	driftMu sync.Mutex
 
 	mrDetected       *prometheus.HistogramVec
 	mrFirstTimeReady *prometheus.HistogramVec
 	mrDeletion       *prometheus.HistogramVec
 	mrDrift          *prometheus.HistogramVec
+	mrNotUpToDate    *prometheus.GaugeVec
+	mrDriftDuration  *prometheus.HistogramVec
+	mrOldestDrift    *prometheus.GaugeVec
+	mrSkipped        *prometheus.CounterVec
+	mrPhase          *prometheus.HistogramVec
 }
 
 // NewMRMetricRecorder returns a new MRMetricRecorder which records metrics for managed resources.
@@ -80,6 +170,33 @@ func NewMRMetricRecorder() *MRMetricRecorder {
 			Help:      "ALPHA: How long since the previous successful reconcile when a resource was found to be out of sync; excludes restart of the provider",
 			Buckets:   kmetrics.ExponentialBuckets(10e-9, 10, 10),
 		}, []string{"gvk"}),
+		mrNotUpToDate: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Subsystem: subSystem,
+			Name:      "managed_resource_not_up_to_date",
+			Help:      "ALPHA: The number of managed resources whose external resource was last observed not to be up to date",
+		}, []string{"gvk"}),
+		mrDriftDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Subsystem: subSystem,
+			Name:      "managed_resource_not_up_to_date_seconds",
+			Help:      "ALPHA: How long a managed resource's external resource was observed not to be up to date before it was observed to be up to date again; excludes restart of the provider",
+			Buckets:   []float64{1, 5, 10, 15, 30, 60, 120, 300, 600, 1800, 3600},
+		}, []string{"gvk"}),
+		mrOldestDrift: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Subsystem: subSystem,
+			Name:      "managed_resource_not_up_to_date_oldest_seconds",
+			Help:      "ALPHA: How long the managed resource that has been not up to date the longest has been observed not to be up to date; excludes restart of the provider",
+		}, []string{"gvk"}),
+		mrSkipped: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Subsystem: subSystem,
+			Name:      "managed_resource_reconcile_skipped_total",
+			Help:      "ALPHA: The number of reconciles that intentionally skipped operations on the external resource, by reason",
+		}, []string{"gvk", "reason"}),
+		mrPhase: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Subsystem: subSystem,
+			Name:      "managed_resource_reconcile_phase_duration_seconds",
+			Help:      "ALPHA: How long each phase of a managed resource reconcile took",
+			Buckets:   kmetrics.ExponentialBuckets(0.001, 2, 17),
+		}, []string{"gvk", "phase"}),
 	}
 }
 
@@ -91,6 +208,11 @@ func (r *MRMetricRecorder) Describe(ch chan<- *prometheus.Desc) {
 	r.mrFirstTimeReady.Describe(ch)
 	r.mrDeletion.Describe(ch)
 	r.mrDrift.Describe(ch)
+	r.mrNotUpToDate.Describe(ch)
+	r.mrDriftDuration.Describe(ch)
+	r.mrOldestDrift.Describe(ch)
+	r.mrSkipped.Describe(ch)
+	r.mrPhase.Describe(ch)
 }
 
 // Collect is called by the Prometheus registry when collecting
@@ -101,6 +223,38 @@ func (r *MRMetricRecorder) Collect(ch chan<- prometheus.Metric) {
 	r.mrFirstTimeReady.Collect(ch)
 	r.mrDeletion.Collect(ch)
 	r.mrDrift.Collect(ch)
+	r.mrNotUpToDate.Collect(ch)
+	r.mrDriftDuration.Collect(ch)
+	r.collectOldestDrift(ch)
+	r.mrSkipped.Collect(ch)
+	r.mrPhase.Collect(ch)
+}
+
+// collectOldestDrift computes the age of the oldest ongoing drift of each GVK.
+// Drift that is never remediated is never observed by the not up to date
+// histogram, so its age is exposed here instead.
+func (r *MRMetricRecorder) collectOldestDrift(ch chan<- prometheus.Metric) {
+	r.driftMu.Lock()
+	defer r.driftMu.Unlock()
+
+	now := time.Now()
+	oldest := map[string]time.Duration{}
+	r.driftObservation.Range(func(_, v any) bool {
+		d, ok := v.(drift)
+		if !ok {
+			return true
+		}
+		if age := now.Sub(d.since); age > oldest[d.gvk] {
+			oldest[d.gvk] = age
+		}
+		return true
+	})
+
+	r.mrOldestDrift.Reset()
+	for gvk, age := range oldest {
+		r.mrOldestDrift.With(prometheus.Labels{"gvk": gvk}).Set(age.Seconds())
+	}
+	r.mrOldestDrift.Collect(ch)
 }
 
 func (r *MRMetricRecorder) recordUnchanged(name string) {
@@ -132,8 +286,52 @@ func (r *MRMetricRecorder) recordDrift(managed resource.Managed) {
 	r.lastObservation.Store(name, time.Now())
 }
 
+func (r *MRMetricRecorder) recordNotUpToDate(managed resource.Managed) {
+	// Only the first observation of a drift marks its start. Subsequent
+	// observations while the resource remains out of date are ignored.
+	l := getLabels(managed)
+	if _, loaded := r.driftObservation.LoadOrStore(managed.GetUID(), drift{gvk: l["gvk"], since: time.Now()}); !loaded {
+		r.mrNotUpToDate.With(l).Inc()
+	}
+}
+
+func (r *MRMetricRecorder) recordUpToDate(managed resource.Managed) {
+	first, ok := r.driftObservation.LoadAndDelete(managed.GetUID())
+	if !ok {
+		return
+	}
+
+	r.mrNotUpToDate.With(getLabels(managed)).Dec()
+
+	d, ok := first.(drift)
+	if !ok {
+		return
+	}
+
+	r.mrDriftDuration.With(getLabels(managed)).Observe(time.Since(d.since).Seconds())
+}
+
 func (r *MRMetricRecorder) recordDeleted(managed resource.Managed) {
 	r.mrDeletion.With(getLabels(managed)).Observe(time.Since(managed.GetDeletionTimestamp().Time).Seconds())
+
+	// A deleted resource is no longer drifting.
+	if _, ok := r.driftObservation.LoadAndDelete(managed.GetUID()); ok {
+		r.mrNotUpToDate.With(getLabels(managed)).Dec()
+	}
+}
+
+func (r *MRMetricRecorder) recordSkipped(managed resource.Managed, reason string) {
+	l := getLabels(managed)
+	l["reason"] = reason
+	r.mrSkipped.With(l).Inc()
+}
+
+func (r *MRMetricRecorder) recordPhases(managed resource.Managed, t *phaseTimer) {
+	for p, d := range t.durations {
+		l := getLabels(managed)
+		l["phase"] = string(p)
+		r.mrPhase.With(l).Observe(d.Seconds())
+	}
 }
 
 func (r *MRMetricRecorder) recordFirstTimeReady(managed resource.Managed) {
@@ -170,10 +368,18 @@ func (r *NopMetricRecorder) recordFirstTimeReconciled(_ resource.Managed) {}
 
 func (r *NopMetricRecorder) recordDrift(_ resource.Managed) {}
 
+func (r *NopMetricRecorder) recordUpToDate(_ resource.Managed) {}
+
+func (r *NopMetricRecorder) recordNotUpToDate(_ resource.Managed) {}
+
 func (r *NopMetricRecorder) recordDeleted(_ resource.Managed) {}
 
 func (r *NopMetricRecorder) recordFirstTimeReady(_ resource.Managed) {}
 
+func (r *NopMetricRecorder) recordSkipped(_ resource.Managed, _ string) {}
+
+func (r *NopMetricRecorder) recordPhases(_ resource.Managed, _ *phaseTimer) {}
+
 func getLabels(r resource.Managed) prometheus.Labels {
 	return prometheus.Labels{
 		"gvk": r.GetObjectKind().GroupVersionKind().String(),
@@ -0,0 +1,173 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package managed
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+)
+
+func TestMRMetricRecorderDrift(t *testing.T) {
+	notUpToDate := func(r *MRMetricRecorder, mg resource.Managed) { r.recordNotUpToDate(mg) }
+	upToDate := func(r *MRMetricRecorder, mg resource.Managed) { r.recordUpToDate(mg) }
+	deleted := func(r *MRMetricRecorder, mg resource.Managed) { r.recordDeleted(mg) }
+
+	type want struct {
+		notUpToDate float64
+		observed    uint64
+		oldest      int
+	}
+
+	cases := map[string]struct {
+		reason string
+		ops    []func(r *MRMetricRecorder, mg resource.Managed)
+		want   want
+	}{
+		"NotUpToDate": {
+			reason: "A resource observed not to be up to date should be counted and its drift age exposed.",
+			ops:    []func(r *MRMetricRecorder, mg resource.Managed){notUpToDate},
+			want:   want{notUpToDate: 1, observed: 0, oldest: 1},
+		},
+		"RepeatedNotUpToDate": {
+			reason: "A resource observed not to be up to date several times in a row should only be counted once.",
+			ops:    []func(r *MRMetricRecorder, mg resource.Managed){notUpToDate, notUpToDate, notUpToDate},
+			want:   want{notUpToDate: 1, observed: 0, oldest: 1},
+		},
+		"UpToDateAfterDrift": {
+			reason: "A drifted resource observed to be up to date again should no longer be counted, and its drift duration should be observed.",
+			ops:    []func(r *MRMetricRecorder, mg resource.Managed){notUpToDate, notUpToDate, upToDate},
+			want:   want{notUpToDate: 0, observed: 1, oldest: 0},
+		},
+		"UpToDateWithoutDrift": {
+			reason: "A resource that never drifted should not affect the drift metrics when observed to be up to date.",
+			ops:    []func(r *MRMetricRecorder, mg resource.Managed){upToDate, upToDate},
+			want:   want{notUpToDate: 0, observed: 0, oldest: 0},
+		},
+		"DeletedWhileDrifting": {
+			reason: "A drifted resource that is deleted should no longer be counted, without observing a drift duration.",
+			ops:    []func(r *MRMetricRecorder, mg resource.Managed){notUpToDate, deleted},
+			want:   want{notUpToDate: 0, observed: 0, oldest: 0},
+		},
+		"DriftAgain": {
+			reason: "A resource that drifts again after being remediated should be counted again.",
+			ops:    []func(r *MRMetricRecorder, mg resource.Managed){notUpToDate, upToDate, notUpToDate},
+			want:   want{notUpToDate: 1, observed: 1, oldest: 1},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			now := metav1.Now()
+			mg := &fake.ModernManaged{ObjectMeta: metav1.ObjectMeta{UID: types.UID("cool-uid"), DeletionTimestamp: &now}}
+
+			r := NewMRMetricRecorder()
+			for _, op := range tc.ops {
+				op(r, mg)
+			}
+
+			g := &dto.Metric{}
+			if err := r.mrNotUpToDate.With(getLabels(mg)).Write(g); err != nil {
+				t.Fatalf("Write(...): %v", err)
+			}
+			if diff := cmp.Diff(tc.want.notUpToDate, g.GetGauge().GetValue()); diff != "" {
+				t.Errorf("\n%s\nmrNotUpToDate: -want, +got:\n%s", tc.reason, diff)
+			}
+
+			h := &dto.Metric{}
+			if err := r.mrDriftDuration.With(getLabels(mg)).(prometheus.Metric).Write(h); err != nil {
+				t.Fatalf("Write(...): %v", err)
+			}
+			if diff := cmp.Diff(tc.want.observed, h.GetHistogram().GetSampleCount()); diff != "" {
+				t.Errorf("\n%s\nmrDriftDuration sample count: -want, +got:\n%s", tc.reason, diff)
+			}
+
+			ch := make(chan prometheus.Metric, 10)
+			r.collectOldestDrift(ch)
+			close(ch)
+			oldest := 0
+			for range ch {
+				oldest++
+			}
+			if diff := cmp.Diff(tc.want.oldest, oldest); diff != "" {
+				t.Errorf("\n%s\nmrOldestDrift series: -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestPhaseTimer(t *testing.T) {
+	type step struct {
+		elapsed time.Duration
+		phase   reconcilePhase
+	}
+
+	cases := map[string]struct {
+		reason string
+		steps  []step
+		want   []any
+	}{
+		"NoPhases": {
+			reason: "A timer with no phases done should return no keys and values.",
+			want:   []any{},
+		},
+		"Ordered": {
+			reason: "Phases should be returned in the order they happen during a reconcile, regardless of the order they were done in.",
+			steps: []step{
+				{elapsed: 1 * time.Second, phase: phaseGet},
+				{elapsed: 4 * time.Second, phase: phaseObserve},
+				{elapsed: 2 * time.Second, phase: phaseConnect},
+				{elapsed: 8 * time.Second, phase: phaseDisconnect},
+			},
+			want: []any{"get", "1s", "connect", "2s", "observe", "4s", "disconnect", "8s"},
+		},
+		"Summed": {
+			reason: "A phase that is done more than once should report the sum of its durations.",
+			steps: []step{
+				{elapsed: 1 * time.Second, phase: phaseWriteBack},
+				{elapsed: 2 * time.Second, phase: phaseMutate},
+				{elapsed: 3 * time.Second, phase: phaseWriteBack},
+				{elapsed: 4 * time.Second, phase: phaseMutate},
+			},
+			want: []any{"mutate", "6s", "write-back", "4s"},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			now := time.Now()
+			tm := &phaseTimer{now: func() time.Time { return now }, last: now, durations: map[reconcilePhase]time.Duration{}}
+
+			for _, s := range tc.steps {
+				now = now.Add(s.elapsed)
+				tm.done(s.phase)
+			}
+
+			if diff := cmp.Diff(tc.want, tm.keysAndValues()); diff != "" {
+				t.Errorf("\n%s\nkeysAndValues(): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
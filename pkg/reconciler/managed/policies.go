@@ -109,6 +109,27 @@ func defaultSupportedManagementPolicies() []sets.Set[xpv1.ManagementAction] {
 	}
 }
 
+// readOnlyManagementPolicies wraps a ManagementPoliciesChecker, only allowing
+// the Observe action regardless of the wrapped management policies.
+type readOnlyManagementPolicies struct {
+	ManagementPoliciesChecker
+}
+
+// ShouldOnlyObserve always returns true.
+func (m *readOnlyManagementPolicies) ShouldOnlyObserve() bool { return true }
+
+// ShouldCreate always returns false.
+func (m *readOnlyManagementPolicies) ShouldCreate() bool { return false }
+
+// ShouldLateInitialize always returns false.
+func (m *readOnlyManagementPolicies) ShouldLateInitialize() bool { return false }
+
+// ShouldUpdate always returns false.
+func (m *readOnlyManagementPolicies) ShouldUpdate() bool { return false }
+
+// ShouldDelete always returns false.
+func (m *readOnlyManagementPolicies) ShouldDelete() bool { return false }
+
 // NewManagementPoliciesResolver returns an ManagementPolicyChecker based
 // on the management policies and if the management policies feature
 // is enabled.
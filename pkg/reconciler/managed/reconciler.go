@@ -18,6 +18,7 @@ package managed
 
 import (
 	"context"
+	"hash/fnv"
 	"math/rand"
 	"strings"
 	"time"
@@ -26,6 +27,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
@@ -87,12 +89,14 @@ const (
 	reasonCannotUpdateManaged     event.Reason = "CannotUpdateManagedResource"
 	reasonManagementPolicyInvalid event.Reason = "CannotUseInvalidManagementPolicy"
 
-	reasonDeleted event.Reason = "DeletedExternalResource"
-	reasonCreated event.Reason = "CreatedExternalResource"
-	reasonUpdated event.Reason = "UpdatedExternalResource"
-	reasonPending event.Reason = "PendingExternalResource"
+	reasonDeleted  event.Reason = "DeletedExternalResource"
+	reasonCreated  event.Reason = "CreatedExternalResource"
+	reasonUpdated  event.Reason = "UpdatedExternalResource"
+	reasonPending  event.Reason = "PendingExternalResource"
+	reasonOrphaned event.Reason = "OrphanedExternalResource"
 
 	reasonReconciliationPaused event.Reason = "ReconciliationPaused"
+	reasonReadOnly             event.Reason = "ReconcilerReadOnly"
 )
 
 // ControllerName returns the recommended name for controllers that use this
@@ -577,6 +581,8 @@ type Reconciler struct {
 	timeout             time.Duration
 	creationGracePeriod time.Duration
 
+	readOnly func() bool
+
 	features feature.Flags
 
 	// The below structs embed the set of interfaces used to implement the
@@ -710,6 +716,40 @@ func WithPollJitterHook(jitter time.Duration) ReconcilerOption {
 	})
 }
 
+// WithPollSpreadHook adds a PollIntervalHook that spreads polls of managed
+// resources evenly across the poll interval. Each managed resource is assigned
+// a stable offset within the poll interval, derived from its UID, and is
+// requeued to be polled at that offset. This prevents managed resources that
+// were reconciled at the same time, for example when the provider started,
+// from being polled in lockstep thereafter. A managed resource is never
+// requeued sooner than half the poll interval, or later than one and a half
+// times the poll interval. This option wraps WithPollIntervalHook, and is
+// subject to the same constraint that only the latest hook will be used.
+func WithPollSpreadHook() ReconcilerOption {
+	return WithPollIntervalHook(func(mg resource.Managed, pollInterval time.Duration) time.Duration {
+		return spreadPollInterval(mg, pollInterval, time.Now())
+	})
+}
+
+func spreadPollInterval(mg resource.Managed, pollInterval time.Duration, now time.Time) time.Duration {
+	if pollInterval <= 0 {
+		return pollInterval
+	}
+
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(mg.GetUID()))
+
+	offset := time.Duration(h.Sum64() % uint64(pollInterval))
+	elapsed := time.Duration(now.UnixNano() % int64(pollInterval))
+
+	wait := (offset - elapsed + pollInterval) % pollInterval
+	if wait < pollInterval/2 {
+		wait += pollInterval
+	}
+
+	return wait
+}
+
 // WithCreationGracePeriod configures an optional period during which we will
 // wait for the external API to report that a newly created external resource
 // exists. This allows us to tolerate eventually consistent APIs that do not
@@ -841,6 +881,20 @@ func WithDeterministicExternalName(b bool) ReconcilerOption {
 	}
 }
 
+// WithReadOnly configures the Reconciler to only observe external resources
+// while the supplied function returns true, regardless of the management
+// policies of the managed resources it reconciles. Deletion of managed
+// resources is blocked while the Reconciler is read-only, so that external
+// resources are neither deleted nor orphaned. The function is called once per
+// reconcile, so it may be backed by a toggle that changes at runtime. This is
+// intended as a break-glass control for when all changes to external resources
+// must stop, without losing visibility into their state.
+func WithReadOnly(fn func() bool) ReconcilerOption {
+	return func(r *Reconciler) {
+		r.readOnly = fn
+	}
+}
+
 // NewReconciler returns a Reconciler that reconciles managed resources of the
 // supplied ManagedKind with resources in an external system such as a cloud
 // provider API. It panics if asked to reconcile a managed resource kind that is
@@ -889,6 +943,13 @@ func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (resu
 	defer func() { result, err = errors.SilentlyRequeueOnConflict(result, err) }()
 
 	log := r.log.WithValues("request", req)
+
+	// Include the ID controller-runtime assigns to each reconcile, using the
+	// same key it does, so our logs can be correlated with its logs.
+	if id := controller.ReconcileIDFromContext(ctx); id != "" {
+		log = log.WithValues("reconcileID", id)
+	}
+
 	log.Debug("Reconciling")
 
 	ctx, cancel := context.WithTimeout(ctx, r.timeout+reconcileGracePeriod)
@@ -897,6 +958,8 @@ func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (resu
 	externalCtx, externalCancel := context.WithTimeout(ctx, r.timeout)
 	defer externalCancel()
 
+	timer := newPhaseTimer()
+
 	managed := r.newManaged()
 	if err := r.client.Get(ctx, req.NamespacedName, managed); err != nil {
 		// There's no need to requeue if we no longer exist. Otherwise we'll be
@@ -905,12 +968,23 @@ func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (resu
 		return reconcile.Result{}, errors.Wrap(resource.IgnoreNotFound(err), errGetManaged)
 	}
 
+	timer.done(phaseGet)
+
+	defer func() {
+		// Whatever happens after the last phase is (mostly) writing back the
+		// managed resource's status.
+		timer.done(phaseWriteBack)
+		r.metricRecorder.recordPhases(managed, timer)
+		log.Debug("Reconcile phase durations", timer.keysAndValues()...)
+	}()
+
 	r.metricRecorder.recordFirstTimeReconciled(managed)
 	status := r.conditions.For(managed)
 
 	record := r.record.WithAnnotations("external-name", meta.GetExternalName(managed))
 	log = log.WithValues(
 		"uid", managed.GetUID(),
+		"gvk", managed.GetObjectKind().GroupVersionKind().String(),
 		"version", managed.GetResourceVersion(),
 		"external-name", meta.GetExternalName(managed),
 	)
@@ -940,6 +1014,8 @@ func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (resu
 		record.Event(managed, event.Normal(reasonReconciliationPaused, "Reconciliation is paused either through the `spec.managementPolicies` or the pause annotation",
 			"annotation", meta.AnnotationKeyReconciliationPaused))
 		status.MarkConditions(xpv1.ReconcilePaused())
+		r.metricRecorder.recordSkipped(managed, skipReasonPaused)
+		timer.done(phaseInitialize)
 		// if the pause annotation is removed or the management policies changed, we will have a chance to reconcile
 		// again and resume and if status update fails, we will reconcile again to retry to update the status
 		return reconcile.Result{}, errors.Wrap(r.client.Status().Update(ctx, managed), errUpdateManagedStatus)
@@ -962,14 +1038,37 @@ func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (resu
 
 		record.Event(managed, event.Warning(reasonManagementPolicyInvalid, err))
 		status.MarkConditions(xpv1.ReconcileError(err))
+		timer.done(phaseInitialize)
 
 		return reconcile.Result{}, errors.Wrap(r.client.Status().Update(ctx, managed), errUpdateManagedStatus)
 	}
 
+	// If the reconciler is read-only we only observe the external resource,
+	// regardless of the management policies. We don't process deletions at
+	// all, because doing so would either delete or orphan the external
+	// resource. We requeue after the poll interval because nothing else will
+	// trigger a reconcile when the reconciler stops being read-only.
+	readOnly := r.readOnly != nil && r.readOnly()
+	if readOnly {
+		if meta.WasDeleted(managed) {
+			log.Debug("Deletion is blocked while the reconciler is read-only")
+			record.Event(managed, event.Normal(reasonReadOnly, "Deletion is blocked while the reconciler is read-only"))
+			status.MarkConditions(xpv1.Deleting(), xpv1.ReconcilePaused())
+			r.metricRecorder.recordSkipped(managed, skipReasonReadOnly)
+			timer.done(phaseInitialize)
+
+			return reconcile.Result{RequeueAfter: r.pollInterval}, errors.Wrap(r.client.Status().Update(ctx, managed), errUpdateManagedStatus)
+		}
+
+		policy = &readOnlyManagementPolicies{ManagementPoliciesChecker: policy}
+	}
+
 	// If managed resource has a deletion timestamp and a deletion policy of
 	// Orphan, we do not need to observe the external resource before attempting
 	// to unpublish connection details and remove finalizer.
 	if meta.WasDeleted(managed) && !policy.ShouldDelete() {
+		timer.done(phaseInitialize)
+
 		log = log.WithValues("deletion-timestamp", managed.GetDeletionTimestamp())
 
 		// Empty ConnectionDetails are passed to UnpublishConnection because we
@@ -1016,6 +1115,7 @@ func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (resu
 		// longer exist and thus there is no point trying to update its status.
 		r.metricRecorder.recordDeleted(managed)
 		log.Debug("Successfully deleted managed resource")
+		record.Event(managed, event.Normal(reasonOrphaned, "Orphaned external resource"))
 
 		return reconcile.Result{Requeue: false}, nil
 	}
@@ -1032,6 +1132,7 @@ func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (resu
 
 		record.Event(managed, event.Warning(reasonCannotInitialize, err))
 		status.MarkConditions(xpv1.ReconcileError(err))
+		timer.done(phaseInitialize)
 
 		return reconcile.Result{Requeue: true}, errors.Wrap(r.client.Status().Update(ctx, managed), errUpdateManagedStatus)
 	}
@@ -1046,6 +1147,7 @@ func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (resu
 			log.Debug(errCreateIncomplete)
 			record.Event(managed, event.Warning(reasonCannotInitialize, errors.New(errCreateIncomplete)))
 			status.MarkConditions(xpv1.Creating(), xpv1.ReconcileError(errors.New(errCreateIncomplete)))
+			timer.done(phaseInitialize)
 
 			return reconcile.Result{Requeue: false}, errors.Wrap(r.client.Status().Update(ctx, managed), errUpdateManagedStatus)
 		}
@@ -1077,12 +1179,17 @@ func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (resu
 
 			record.Event(managed, event.Warning(reasonCannotResolveRefs, err))
 			status.MarkConditions(xpv1.ReconcileError(err))
+			timer.done(phaseInitialize)
 
 			return reconcile.Result{Requeue: true}, errors.Wrap(r.client.Status().Update(ctx, managed), errUpdateManagedStatus)
 		}
 	}
 
+	timer.done(phaseInitialize)
+
 	external, err := r.external.Connect(externalCtx, managed)
+	timer.done(phaseConnect)
+
 	if err != nil {
 		// We'll usually hit this case if our Provider or its secret are missing
 		// or invalid. If this is first time we encounter this issue we'll be
@@ -1102,6 +1209,11 @@ func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (resu
 	}
 
 	defer func() {
+		// This runs before the phase durations are recorded, so close out
+		// the write-back phase before disconnecting.
+		timer.done(phaseWriteBack)
+		defer timer.done(phaseDisconnect)
+
 		if err := r.external.Disconnect(ctx); err != nil {
 			log.Debug("Cannot disconnect from provider", "error", err)
 			record.Event(managed, event.Warning(reasonCannotDisconnect, err))
@@ -1114,6 +1226,8 @@ func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (resu
 	}()
 
 	observation, err := external.Observe(externalCtx, managed)
+	timer.done(phaseObserve)
+
 	if err != nil {
 		// We'll usually hit this case if our Provider credentials are invalid
 		// or insufficient for observing the external resource type we're
@@ -1133,6 +1247,18 @@ func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (resu
 		return reconcile.Result{Requeue: true}, errors.Wrap(r.client.Status().Update(ctx, managed), errUpdateManagedStatus)
 	}
 
+	// A read-only reconciler holds back creation on purpose, so an external
+	// resource that does not exist isn't an error. Like a blocked deletion we
+	// report it as paused and check again after the poll interval.
+	if readOnly && !observation.ResourceExists {
+		log.Debug("Creation is blocked while the reconciler is read-only")
+		record.Event(managed, event.Normal(reasonReadOnly, "Creation is blocked while the reconciler is read-only"))
+		status.MarkConditions(xpv1.Creating(), xpv1.ReconcilePaused())
+		r.metricRecorder.recordSkipped(managed, skipReasonReadOnly)
+
+		return reconcile.Result{RequeueAfter: r.pollInterval}, errors.Wrap(r.client.Status().Update(ctx, managed), errUpdateManagedStatus)
+	}
+
 	// In the observe-only mode, !observation.ResourceExists will be an error
 	// case, and we will explicitly return this information to the user.
 	if !observation.ResourceExists && policy.ShouldOnlyObserve() {
@@ -1165,6 +1291,8 @@ func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (resu
 
 		if observation.ResourceExists && policy.ShouldDelete() {
 			deletion, err := external.Delete(externalCtx, managed)
+			timer.done(phaseMutate)
+
 			if err != nil {
 				// We'll hit this condition if we can't delete our external
 				// resource, for example if our provider credentials don't have
@@ -1300,7 +1428,11 @@ func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (resu
 			return reconcile.Result{Requeue: true}, errors.Wrap(r.client.Status().Update(ctx, managed), errUpdateManagedStatus)
 		}
 
+		timer.done(phaseWriteBack)
+
 		creation, err := external.Create(externalCtx, managed)
+		timer.done(phaseMutate)
+
 		if err != nil {
 			// We'll hit this condition if we can't create our external
 			// resource, for example if our provider credentials don't have
@@ -1429,6 +1561,7 @@ func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (resu
 		log.Debug("External resource is up to date", "requeue-after", time.Now().Add(reconcileAfter))
 		status.MarkConditions(xpv1.ReconcileSuccess())
 		r.metricRecorder.recordFirstTimeReady(managed)
+		r.metricRecorder.recordUpToDate(managed)
 
 		// record that we intentionally did not update the managed resource
 		// because no drift was detected. We call this so late in the reconcile
@@ -1439,6 +1572,10 @@ func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (resu
 		return reconcile.Result{RequeueAfter: reconcileAfter}, errors.Wrap(r.client.Status().Update(ctx, managed), errUpdateManagedStatus)
 	}
 
+	// Record that the external resource has drifted from the desired state.
+	// This is true regardless of whether we're allowed to update it below.
+	r.metricRecorder.recordNotUpToDate(managed)
+
 	if observation.Diff != "" {
 		log.Debug("External resource differs from desired state", "diff", observation.Diff)
 	}
@@ -1449,10 +1586,23 @@ func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (resu
 		log.Debug("Skipping update due to managementPolicies. Reconciliation succeeded", "requeue-after", time.Now().Add(reconcileAfter))
 		status.MarkConditions(xpv1.ReconcileSuccess())
 
+		switch {
+		case readOnly:
+			r.metricRecorder.recordSkipped(managed, skipReasonReadOnly)
+		case policy.ShouldOnlyObserve():
+			r.metricRecorder.recordSkipped(managed, skipReasonObserveOnly)
+		default:
+			r.metricRecorder.recordSkipped(managed, skipReasonUpdateNotAllowed)
+		}
+
 		return reconcile.Result{RequeueAfter: reconcileAfter}, errors.Wrap(r.client.Status().Update(ctx, managed), errUpdateManagedStatus)
 	}
 
+	timer.done(phaseWriteBack)
+
 	update, err := external.Update(externalCtx, managed)
+	timer.done(phaseMutate)
+
 	if err != nil {
 		// We'll hit this condition if we can't update our external resource,
 		// for example if our provider credentials don't have access to update
@@ -22,19 +22,29 @@ import (
 	"testing"
 	"time"
 
+	"github.com/go-logr/logr"
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
 	kerrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
 
 	"github.com/crossplane/crossplane-runtime/apis/changelogs/proto/v1alpha1"
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
@@ -1064,6 +1074,48 @@ func TestModernReconciler(t *testing.T) {
 				})},
 			},
 		},
+		"ExternalResourceUpToDateWithPollSpread": {
+			reason: "When the external resource exists and is up to date a requeue should be triggered after a long wait spread across the poll interval.",
+			args: args{
+				m: &fake.Manager{
+					Client: &test.MockClient{
+						MockGet: modernManagedMockGetFn(nil, 42),
+						MockStatusUpdate: test.MockSubResourceUpdateFn(func(_ context.Context, _ client.Object, _ ...client.SubResourceUpdateOption) error {
+							return nil
+						}),
+					},
+					Scheme: fake.SchemeWith(&fake.ModernManaged{}),
+				},
+				mg: resource.ManagedKind(fake.GVK(&fake.ModernManaged{})),
+				o: []ReconcilerOption{
+					WithInitializers(),
+					WithReferenceResolver(ReferenceResolverFn(func(_ context.Context, _ resource.Managed) error { return nil })),
+					WithExternalConnector(ExternalConnectorFn(func(_ context.Context, _ resource.Managed) (ExternalClient, error) {
+						c := &ExternalClientFns{
+							ObserveFn: func(_ context.Context, _ resource.Managed) (ExternalObservation, error) {
+								return ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, nil
+							},
+							DisconnectFn: func(_ context.Context) error {
+								return nil
+							},
+						}
+						return c, nil
+					})),
+					WithFinalizer(resource.FinalizerFns{AddFinalizerFn: func(_ context.Context, _ resource.Object) error { return nil }}),
+					WithPollSpreadHook(),
+				},
+			},
+			want: want{
+				result: reconcile.Result{RequeueAfter: defaultPollInterval},
+				resultCmpOpts: []cmp.Option{cmp.Comparer(func(l, r time.Duration) bool {
+					diff := l - r
+					if diff < 0 {
+						diff = -diff
+					}
+					return diff < defaultPollInterval/2
+				})},
+			},
+		},
 		"ExternalResourceUpToDateWithPollIntervalHook": {
 			reason: "When the external resource exists and is up to date a requeue should be triggered after a long wait processed by the interval hook.",
 			args: args{
@@ -1794,6 +1846,126 @@ func TestModernReconciler(t *testing.T) {
 			},
 			want: want{result: reconcile.Result{RequeueAfter: defaultPollInterval}},
 		},
+		"ReadOnlyExternalResourceNotUpToDate": {
+			reason: "A read-only reconciler should not late initialize or update an external resource that is not up to date, and should requeue after a long wait.",
+			args: args{
+				m: &fake.Manager{
+					Client: &test.MockClient{
+						MockGet:    modernManagedMockGetFn(nil, 42),
+						MockUpdate: test.NewMockUpdateFn(errBoom),
+						MockStatusUpdate: test.MockSubResourceUpdateFn(func(_ context.Context, obj client.Object, _ ...client.SubResourceUpdateOption) error {
+							want := newModernManaged(42)
+							want.SetConditions(xpv1.ReconcileSuccess().WithObservedGeneration(42))
+							if diff := cmp.Diff(want, obj, test.EquateConditions()); diff != "" {
+								reason := `Managed resource should acquire Synced=True/ReconcileSuccess status condition.`
+								t.Errorf("\nReason: %s\n-want, +got:\n%s", reason, diff)
+							}
+							return nil
+						}),
+					},
+					Scheme: fake.SchemeWith(&fake.ModernManaged{}),
+				},
+				mg: resource.ManagedKind(fake.GVK(&fake.ModernManaged{})),
+				o: []ReconcilerOption{
+					WithInitializers(),
+					WithReadOnly(func() bool { return true }),
+					WithReferenceResolver(ReferenceResolverFn(func(_ context.Context, _ resource.Managed) error { return nil })),
+					WithExternalConnector(ExternalConnectorFn(func(_ context.Context, _ resource.Managed) (ExternalClient, error) {
+						c := &ExternalClientFns{
+							ObserveFn: func(_ context.Context, _ resource.Managed) (ExternalObservation, error) {
+								return ExternalObservation{ResourceExists: true, ResourceUpToDate: false, ResourceLateInitialized: true}, nil
+							},
+							UpdateFn: func(_ context.Context, _ resource.Managed) (ExternalUpdate, error) {
+								return ExternalUpdate{}, errBoom
+							},
+							DisconnectFn: func(_ context.Context) error {
+								return nil
+							},
+						}
+						return c, nil
+					})),
+					WithFinalizer(resource.FinalizerFns{AddFinalizerFn: func(_ context.Context, _ resource.Object) error { return nil }}),
+				},
+			},
+			want: want{result: reconcile.Result{RequeueAfter: defaultPollInterval}},
+		},
+		"ReadOnlyExternalResourceDoesNotExist": {
+			reason: "A read-only reconciler should not create an external resource that does not exist, and should requeue after a long wait.",
+			args: args{
+				m: &fake.Manager{
+					Client: &test.MockClient{
+						MockGet: modernManagedMockGetFn(nil, 42),
+						MockStatusUpdate: test.MockSubResourceUpdateFn(func(_ context.Context, obj client.Object, _ ...client.SubResourceUpdateOption) error {
+							want := newModernManaged(42)
+							want.SetConditions(xpv1.Creating().WithObservedGeneration(42))
+							want.SetConditions(xpv1.ReconcilePaused().WithObservedGeneration(42))
+							if diff := cmp.Diff(want, obj, test.EquateConditions()); diff != "" {
+								reason := "A read-only reconciler should report that creation is blocked as a conditioned status."
+								t.Errorf("\nReason: %s\n-want, +got:\n%s", reason, diff)
+							}
+							return nil
+						}),
+					},
+					Scheme: fake.SchemeWith(&fake.ModernManaged{}),
+				},
+				mg: resource.ManagedKind(fake.GVK(&fake.ModernManaged{})),
+				o: []ReconcilerOption{
+					WithInitializers(),
+					WithReadOnly(func() bool { return true }),
+					WithReferenceResolver(ReferenceResolverFn(func(_ context.Context, _ resource.Managed) error { return nil })),
+					WithExternalConnector(ExternalConnectorFn(func(_ context.Context, _ resource.Managed) (ExternalClient, error) {
+						c := &ExternalClientFns{
+							ObserveFn: func(_ context.Context, _ resource.Managed) (ExternalObservation, error) {
+								return ExternalObservation{ResourceExists: false}, nil
+							},
+							CreateFn: func(_ context.Context, _ resource.Managed) (ExternalCreation, error) {
+								return ExternalCreation{}, errBoom
+							},
+							DisconnectFn: func(_ context.Context) error {
+								return nil
+							},
+						}
+						return c, nil
+					})),
+				},
+			},
+			want: want{result: reconcile.Result{RequeueAfter: defaultPollInterval}},
+		},
+		"ReadOnlyDeletionBlocked": {
+			reason: "A read-only reconciler should neither delete nor orphan the external resource of a deleted managed resource, and should requeue after a long wait.",
+			args: args{
+				m: &fake.Manager{
+					Client: &test.MockClient{
+						MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+							mg := asModernManaged(obj, 42)
+							mg.SetDeletionTimestamp(&now)
+							return nil
+						}),
+						MockStatusUpdate: test.MockSubResourceUpdateFn(func(_ context.Context, obj client.Object, _ ...client.SubResourceUpdateOption) error {
+							want := newModernManaged(42)
+							want.SetDeletionTimestamp(&now)
+							want.SetConditions(xpv1.Deleting().WithObservedGeneration(42))
+							want.SetConditions(xpv1.ReconcilePaused().WithObservedGeneration(42))
+							if diff := cmp.Diff(want, obj, test.EquateConditions()); diff != "" {
+								reason := "A read-only reconciler should report that deletion is blocked as a conditioned status."
+								t.Errorf("\nReason: %s\n-want, +got:\n%s", reason, diff)
+							}
+							return nil
+						}),
+					},
+					Scheme: fake.SchemeWith(&fake.ModernManaged{}),
+				},
+				mg: resource.ManagedKind(fake.GVK(&fake.ModernManaged{})),
+				o: []ReconcilerOption{
+					WithReadOnly(func() bool { return true }),
+					WithExternalConnector(ExternalConnectorFn(func(_ context.Context, _ resource.Managed) (ExternalClient, error) {
+						return nil, errBoom
+					})),
+					WithFinalizer(resource.FinalizerFns{RemoveFinalizerFn: func(_ context.Context, _ resource.Object) error { return errBoom }}),
+				},
+			},
+			want: want{result: reconcile.Result{RequeueAfter: defaultPollInterval}},
+		},
 		"ManagementPolicyAllUpdateSuccessful": {
 			reason: "A successful managed resource update using management policies should trigger a requeue after a long wait.",
 			args: args{
@@ -2005,6 +2177,67 @@ func TestModernReconciler(t *testing.T) {
 	}
 }
 
+func TestSpreadPollInterval(t *testing.T) {
+	pollInterval := 10 * time.Minute
+
+	type poll struct {
+		uid string
+		now time.Time
+	}
+
+	cases := map[string]struct {
+		reason   string
+		polls    []poll
+		wantSame bool
+	}{
+		"StableOffset": {
+			reason: "A managed resource should be polled at the same offset within the poll interval regardless of when it was reconciled.",
+			polls: []poll{
+				{uid: "cool-uid", now: time.Unix(0, 0)},
+				{uid: "cool-uid", now: time.Unix(0, 0).Add(7 * time.Minute)},
+				{uid: "cool-uid", now: time.Unix(0, 0).Add(23*time.Minute + 13*time.Second)},
+			},
+			wantSame: true,
+		},
+		"DifferentResources": {
+			reason: "Different managed resources reconciled at the same time should be polled at different offsets within the poll interval.",
+			polls: []poll{
+				{uid: "cool-uid", now: time.Unix(1000, 0)},
+				{uid: "other-uid", now: time.Unix(1000, 0)},
+				{uid: "another-uid", now: time.Unix(1000, 0)},
+			},
+			wantSame: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			offsets := sets.New[time.Duration]()
+
+			for _, p := range tc.polls {
+				mg := &fake.ModernManaged{}
+				mg.SetUID(types.UID(p.uid))
+
+				got := spreadPollInterval(mg, pollInterval, p.now)
+				if got < pollInterval/2 || got >= pollInterval+pollInterval/2 {
+					t.Errorf("\nReason: %s\nspreadPollInterval(...): want [%s, %s), got %s", tc.reason, pollInterval/2, pollInterval+pollInterval/2, got)
+				}
+
+				offsets.Insert(time.Duration(p.now.Add(got).UnixNano() % int64(pollInterval)))
+			}
+
+			want := len(tc.polls)
+			if tc.wantSame {
+				want = 1
+			}
+
+			if diff := cmp.Diff(want, offsets.Len()); diff != "" {
+				t.Errorf("\nReason: %s\nspreadPollInterval(...): -want distinct offsets, +got distinct offsets:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
 func TestManagementPoliciesResolverIsPaused(t *testing.T) {
 	type args struct {
 		enabled bool
@@ -2667,6 +2900,508 @@ func TestReconcilerChangeLogs(t *testing.T) {
 	}
 }
 
+// A skipRecorder records the reasons reconciles were skipped for.
+type skipRecorder struct {
+	NopMetricRecorder
+
+	reasons []string
+}
+
+func (r *skipRecorder) recordSkipped(_ resource.Managed, reason string) {
+	r.reasons = append(r.reasons, reason)
+}
+
+func TestReconcilerSkippedReasons(t *testing.T) {
+	type args struct {
+		m  manager.Manager
+		mg resource.ManagedKind
+		o  []ReconcilerOption
+	}
+
+	now := metav1.Now()
+	errBoom := errors.New("boom")
+
+	observed := func(o ExternalObservation) ReconcilerOption {
+		return WithExternalConnector(ExternalConnectorFn(func(_ context.Context, _ resource.Managed) (ExternalClient, error) {
+			c := &ExternalClientFns{
+				ObserveFn: func(_ context.Context, _ resource.Managed) (ExternalObservation, error) {
+					return o, nil
+				},
+				UpdateFn: func(_ context.Context, _ resource.Managed) (ExternalUpdate, error) {
+					return ExternalUpdate{}, errBoom
+				},
+				DisconnectFn: func(_ context.Context) error {
+					return nil
+				},
+			}
+			return c, nil
+		}))
+	}
+
+	withPolicies := func(p ...xpv1.ManagementAction) test.MockGetFn {
+		return test.NewMockGetFn(nil, func(obj client.Object) error {
+			mg := asModernManaged(obj, 42)
+			mg.SetManagementPolicies(p)
+			return nil
+		})
+	}
+
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   []string
+	}{
+		"Paused": {
+			reason: "A reconcile of a paused managed resource should be recorded as skipped because it is paused.",
+			args: args{
+				m: &fake.Manager{
+					Client: &test.MockClient{
+						MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+							mg := asModernManaged(obj, 42)
+							mg.SetAnnotations(map[string]string{meta.AnnotationKeyReconciliationPaused: "true"})
+							return nil
+						}),
+						MockStatusUpdate: test.NewMockSubResourceUpdateFn(nil),
+					},
+					Scheme: fake.SchemeWith(&fake.ModernManaged{}),
+				},
+				mg: resource.ManagedKind(fake.GVK(&fake.ModernManaged{})),
+			},
+			want: []string{skipReasonPaused},
+		},
+		"ReadOnlyDeletion": {
+			reason: "A blocked deletion by a read-only reconciler should be recorded as skipped because it is read-only.",
+			args: args{
+				m: &fake.Manager{
+					Client: &test.MockClient{
+						MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+							mg := asModernManaged(obj, 42)
+							mg.SetDeletionTimestamp(&now)
+							return nil
+						}),
+						MockStatusUpdate: test.NewMockSubResourceUpdateFn(nil),
+					},
+					Scheme: fake.SchemeWith(&fake.ModernManaged{}),
+				},
+				mg: resource.ManagedKind(fake.GVK(&fake.ModernManaged{})),
+				o: []ReconcilerOption{
+					WithReadOnly(func() bool { return true }),
+				},
+			},
+			want: []string{skipReasonReadOnly},
+		},
+		"ReadOnlyUpdate": {
+			reason: "A skipped update by a read-only reconciler should be recorded as skipped because it is read-only.",
+			args: args{
+				m: &fake.Manager{
+					Client: &test.MockClient{
+						MockGet:          modernManagedMockGetFn(nil, 42),
+						MockStatusUpdate: test.NewMockSubResourceUpdateFn(nil),
+					},
+					Scheme: fake.SchemeWith(&fake.ModernManaged{}),
+				},
+				mg: resource.ManagedKind(fake.GVK(&fake.ModernManaged{})),
+				o: []ReconcilerOption{
+					WithInitializers(),
+					WithReadOnly(func() bool { return true }),
+					WithReferenceResolver(ReferenceResolverFn(func(_ context.Context, _ resource.Managed) error { return nil })),
+					observed(ExternalObservation{ResourceExists: true, ResourceUpToDate: false}),
+					WithFinalizer(resource.FinalizerFns{AddFinalizerFn: func(_ context.Context, _ resource.Object) error { return nil }}),
+				},
+			},
+			want: []string{skipReasonReadOnly},
+		},
+		"ReadOnlyCreate": {
+			reason: "A blocked creation by a read-only reconciler should be recorded as skipped because it is read-only.",
+			args: args{
+				m: &fake.Manager{
+					Client: &test.MockClient{
+						MockGet:          modernManagedMockGetFn(nil, 42),
+						MockStatusUpdate: test.NewMockSubResourceUpdateFn(nil),
+					},
+					Scheme: fake.SchemeWith(&fake.ModernManaged{}),
+				},
+				mg: resource.ManagedKind(fake.GVK(&fake.ModernManaged{})),
+				o: []ReconcilerOption{
+					WithInitializers(),
+					WithReadOnly(func() bool { return true }),
+					WithReferenceResolver(ReferenceResolverFn(func(_ context.Context, _ resource.Managed) error { return nil })),
+					observed(ExternalObservation{ResourceExists: false}),
+				},
+			},
+			want: []string{skipReasonReadOnly},
+		},
+		"ObserveOnly": {
+			reason: "A skipped update due to an observe only management policy should be recorded as skipped because it is observe only.",
+			args: args{
+				m: &fake.Manager{
+					Client: &test.MockClient{
+						MockGet:          withPolicies(xpv1.ManagementActionObserve),
+						MockStatusUpdate: test.NewMockSubResourceUpdateFn(nil),
+					},
+					Scheme: fake.SchemeWith(&fake.ModernManaged{}),
+				},
+				mg: resource.ManagedKind(fake.GVK(&fake.ModernManaged{})),
+				o: []ReconcilerOption{
+					WithInitializers(),
+					WithManagementPolicies(),
+					observed(ExternalObservation{ResourceExists: true, ResourceUpToDate: false}),
+					withLocalConnectionPublishers(LocalConnectionPublisherFns{
+						PublishConnectionFn: func(_ context.Context, _ resource.LocalConnectionSecretOwner, _ ConnectionDetails) (bool, error) {
+							return false, nil
+						},
+					}),
+					WithFinalizer(resource.FinalizerFns{AddFinalizerFn: func(_ context.Context, _ resource.Object) error { return nil }}),
+				},
+			},
+			want: []string{skipReasonObserveOnly},
+		},
+		"UpdateNotAllowed": {
+			reason: "A skipped update due to management policies that do not allow updates should be recorded as skipped because updates are not allowed.",
+			args: args{
+				m: &fake.Manager{
+					Client: &test.MockClient{
+						MockGet:          withPolicies(xpv1.ManagementActionObserve, xpv1.ManagementActionLateInitialize, xpv1.ManagementActionCreate, xpv1.ManagementActionDelete),
+						MockUpdate:       test.NewMockUpdateFn(nil),
+						MockStatusUpdate: test.NewMockSubResourceUpdateFn(nil),
+					},
+					Scheme: fake.SchemeWith(&fake.ModernManaged{}),
+				},
+				mg: resource.ManagedKind(fake.GVK(&fake.ModernManaged{})),
+				o: []ReconcilerOption{
+					WithInitializers(),
+					WithManagementPolicies(),
+					WithReferenceResolver(ReferenceResolverFn(func(_ context.Context, _ resource.Managed) error { return nil })),
+					observed(ExternalObservation{ResourceExists: true, ResourceUpToDate: false}),
+					WithFinalizer(resource.FinalizerFns{AddFinalizerFn: func(_ context.Context, _ resource.Object) error { return nil }}),
+				},
+			},
+			want: []string{skipReasonUpdateNotAllowed},
+		},
+		"NotSkipped": {
+			reason: "A reconcile that updates the external resource should not be recorded as skipped.",
+			args: args{
+				m: &fake.Manager{
+					Client: &test.MockClient{
+						MockGet:          modernManagedMockGetFn(nil, 42),
+						MockStatusUpdate: test.NewMockSubResourceUpdateFn(nil),
+					},
+					Scheme: fake.SchemeWith(&fake.ModernManaged{}),
+				},
+				mg: resource.ManagedKind(fake.GVK(&fake.ModernManaged{})),
+				o: []ReconcilerOption{
+					WithInitializers(),
+					WithReferenceResolver(ReferenceResolverFn(func(_ context.Context, _ resource.Managed) error { return nil })),
+					observed(ExternalObservation{ResourceExists: true, ResourceUpToDate: false}),
+					WithFinalizer(resource.FinalizerFns{AddFinalizerFn: func(_ context.Context, _ resource.Object) error { return nil }}),
+				},
+			},
+			want: nil,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			mr := &skipRecorder{}
+			r := NewReconciler(tc.args.m, tc.args.mg, append(tc.args.o, WithMetricRecorder(mr))...)
+			r.Reconcile(context.Background(), reconcile.Request{})
+
+			if diff := cmp.Diff(tc.want, mr.reasons); diff != "" {
+				t.Errorf("\nReason: %s\nr.Reconcile(...): -want skipped reasons, +got skipped reasons:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+// A phaseRecorder records the phases of each reconcile.
+type phaseRecorder struct {
+	NopMetricRecorder
+
+	phases []reconcilePhase
+}
+
+func (r *phaseRecorder) recordPhases(_ resource.Managed, t *phaseTimer) {
+	for _, p := range reconcilePhases {
+		if _, ok := t.durations[p]; ok {
+			r.phases = append(r.phases, p)
+		}
+	}
+}
+
+func TestReconcilerPhases(t *testing.T) {
+	type args struct {
+		m  manager.Manager
+		mg resource.ManagedKind
+		o  []ReconcilerOption
+	}
+
+	errBoom := errors.New("boom")
+
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   []reconcilePhase
+	}{
+		"Paused": {
+			reason: "A paused reconcile should attribute time before writing back status to initialize, and the status write to write-back.",
+			args: args{
+				m: &fake.Manager{
+					Client: &test.MockClient{
+						MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+							mg := asModernManaged(obj, 42)
+							mg.SetAnnotations(map[string]string{meta.AnnotationKeyReconciliationPaused: "true"})
+							return nil
+						}),
+						MockStatusUpdate: test.NewMockSubResourceUpdateFn(nil),
+					},
+					Scheme: fake.SchemeWith(&fake.ModernManaged{}),
+				},
+				mg: resource.ManagedKind(fake.GVK(&fake.ModernManaged{})),
+			},
+			want: []reconcilePhase{phaseGet, phaseInitialize, phaseWriteBack},
+		},
+		"ConnectError": {
+			reason: "A reconcile that fails to connect should record the connect phase, and attribute writing back status to write-back.",
+			args: args{
+				m: &fake.Manager{
+					Client: &test.MockClient{
+						MockGet:          modernManagedMockGetFn(nil, 42),
+						MockStatusUpdate: test.NewMockSubResourceUpdateFn(nil),
+					},
+					Scheme: fake.SchemeWith(&fake.ModernManaged{}),
+				},
+				mg: resource.ManagedKind(fake.GVK(&fake.ModernManaged{})),
+				o: []ReconcilerOption{
+					WithInitializers(),
+					WithReferenceResolver(ReferenceResolverFn(func(_ context.Context, _ resource.Managed) error { return nil })),
+					WithExternalConnector(ExternalConnectorFn(func(_ context.Context, _ resource.Managed) (ExternalClient, error) {
+						return nil, errBoom
+					})),
+				},
+			},
+			want: []reconcilePhase{phaseGet, phaseInitialize, phaseConnect, phaseWriteBack},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			mr := &phaseRecorder{}
+			r := NewReconciler(tc.args.m, tc.args.mg, append(tc.args.o, WithMetricRecorder(mr))...)
+			r.Reconcile(context.Background(), reconcile.Request{})
+
+			if diff := cmp.Diff(tc.want, mr.phases); diff != "" {
+				t.Errorf("\nReason: %s\nr.Reconcile(...): -want phases, +got phases:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+// An eventRecorder records the events it is asked to record.
+type eventRecorder struct {
+	events []event.Event
+}
+
+func (r *eventRecorder) Event(_ runtime.Object, e event.Event) {
+	r.events = append(r.events, e)
+}
+
+func (r *eventRecorder) WithAnnotations(_ ...string) event.Recorder { return r }
+
+func TestReconcilerOrphanedEvents(t *testing.T) {
+	type args struct {
+		m  manager.Manager
+		mg resource.ManagedKind
+		o  []ReconcilerOption
+	}
+
+	now := metav1.Now()
+	errBoom := errors.New("boom")
+
+	orphaned := test.NewMockGetFn(nil, func(obj client.Object) error {
+		mg := asModernManaged(obj, 42)
+		mg.SetDeletionTimestamp(&now)
+		mg.SetManagementPolicies(xpv1.ManagementPolicies{xpv1.ManagementActionObserve})
+		return nil
+	})
+
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   []event.Event
+	}{
+		"Orphaned": {
+			reason: "Successfully orphaning the external resource of a deleted managed resource should record an event.",
+			args: args{
+				m: &fake.Manager{
+					Client: &test.MockClient{
+						MockGet: orphaned,
+					},
+					Scheme: fake.SchemeWith(&fake.ModernManaged{}),
+				},
+				mg: resource.ManagedKind(fake.GVK(&fake.ModernManaged{})),
+				o: []ReconcilerOption{
+					WithManagementPolicies(),
+					WithFinalizer(resource.FinalizerFns{RemoveFinalizerFn: func(_ context.Context, _ resource.Object) error { return nil }}),
+				},
+			},
+			want: []event.Event{event.Normal(reasonOrphaned, "Orphaned external resource")},
+		},
+		"RemoveFinalizerError": {
+			reason: "Failing to remove the finalizer of a managed resource whose external resource is orphaned should not record an orphaned event.",
+			args: args{
+				m: &fake.Manager{
+					Client: &test.MockClient{
+						MockGet:          orphaned,
+						MockStatusUpdate: test.NewMockSubResourceUpdateFn(nil),
+					},
+					Scheme: fake.SchemeWith(&fake.ModernManaged{}),
+				},
+				mg: resource.ManagedKind(fake.GVK(&fake.ModernManaged{})),
+				o: []ReconcilerOption{
+					WithManagementPolicies(),
+					WithFinalizer(resource.FinalizerFns{RemoveFinalizerFn: func(_ context.Context, _ resource.Object) error { return errBoom }}),
+				},
+			},
+			want: nil,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			er := &eventRecorder{}
+			r := NewReconciler(tc.args.m, tc.args.mg, append(tc.args.o, WithRecorder(er))...)
+			r.Reconcile(context.Background(), reconcile.Request{})
+
+			if diff := cmp.Diff(tc.want, er.events); diff != "" {
+				t.Errorf("\nReason: %s\nr.Reconcile(...): -want events, +got events:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+// A valuesLogger records the structured data of every message it logs.
+type valuesLogger struct {
+	kv     []any
+	values map[string]any
+}
+
+func (l *valuesLogger) Info(_ string, keysAndValues ...any)  { l.record(keysAndValues) }
+func (l *valuesLogger) Debug(_ string, keysAndValues ...any) { l.record(keysAndValues) }
+
+func (l *valuesLogger) WithValues(keysAndValues ...any) logging.Logger {
+	return &valuesLogger{kv: append(append([]any{}, l.kv...), keysAndValues...), values: l.values}
+}
+
+func (l *valuesLogger) record(keysAndValues []any) {
+	kv := append(append([]any{}, l.kv...), keysAndValues...)
+	for i := 0; i+1 < len(kv); i += 2 {
+		if k, ok := kv[i].(string); ok {
+			l.values[k] = kv[i+1]
+		}
+	}
+}
+
+// reconcileWithController calls the supplied Reconciler once, through a
+// controller-runtime controller that assigns the reconcile an ID.
+func reconcileWithController(t *testing.T, r reconcile.Reconciler) {
+	t.Helper()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	done := make(chan struct{})
+	c, err := controller.NewUnmanaged("test", &fake.Manager{}, controller.Options{
+		Reconciler: reconcile.Func(func(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+			defer close(done)
+			_, _ = r.Reconcile(ctx, req)
+			return reconcile.Result{}, nil
+		}),
+		SkipNameValidation:      ptr.To(true),
+		LogConstructor:          func(_ *reconcile.Request) logr.Logger { return logr.Discard() },
+		MaxConcurrentReconciles: 1,
+		CacheSyncTimeout:        time.Second,
+		RecoverPanic:            ptr.To(false),
+		NeedLeaderElection:      ptr.To(false),
+	})
+	if err != nil {
+		t.Fatalf("controller.NewUnmanaged(...): %v", err)
+	}
+
+	if err := c.Watch(source.Func(func(_ context.Context, q workqueue.TypedRateLimitingInterface[reconcile.Request]) error {
+		q.Add(reconcile.Request{})
+		return nil
+	})); err != nil {
+		t.Fatalf("c.Watch(...): %v", err)
+	}
+
+	go func() { _ = c.Start(ctx) }()
+
+	select {
+	case <-done:
+	case <-time.After(wait.ForeverTestTimeout):
+		t.Fatal("timed out waiting for reconcile")
+	}
+}
+
+func TestReconcilerLogValues(t *testing.T) {
+	type want struct {
+		reconcileID bool
+		uid         any
+		gvk         any
+	}
+
+	// The fake managed resource doesn't record its GVK, so we expect whatever
+	// it reports.
+	gvk := (&fake.ModernManaged{}).GetObjectKind().GroupVersionKind().String()
+
+	cases := map[string]struct {
+		reason         string
+		withController bool
+		want           want
+	}{
+		"Controller": {
+			reason:         "A reconcile started by a controller should log its reconcile ID, and the UID and GVK of the managed resource.",
+			withController: true,
+			want:           want{reconcileID: true, uid: types.UID("cool-uid"), gvk: gvk},
+		},
+		"NoReconcileID": {
+			reason: "A reconcile whose context has no reconcile ID should not log one.",
+			want:   want{reconcileID: false, uid: types.UID("cool-uid"), gvk: gvk},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			log := &valuesLogger{values: map[string]any{}}
+			m := &fake.Manager{
+				Client: &test.MockClient{
+					MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+						mg := asModernManaged(obj, 42)
+						mg.SetUID(types.UID("cool-uid"))
+						mg.SetAnnotations(map[string]string{meta.AnnotationKeyReconciliationPaused: "true"})
+						return nil
+					}),
+					MockStatusUpdate: test.NewMockSubResourceUpdateFn(nil),
+				},
+				Scheme: fake.SchemeWith(&fake.ModernManaged{}),
+			}
+			r := NewReconciler(m, resource.ManagedKind(fake.GVK(&fake.ModernManaged{})), WithLogger(log))
+
+			if tc.withController {
+				reconcileWithController(t, r)
+			} else {
+				r.Reconcile(context.Background(), reconcile.Request{})
+			}
+
+			_, gotID := log.values["reconcileID"]
+			got := want{reconcileID: gotID, uid: log.values["uid"], gvk: log.values["gvk"]}
+			if diff := cmp.Diff(tc.want, got, cmp.AllowUnexported(want{})); diff != "" {
+				t.Errorf("\nReason: %s\nr.Reconcile(...): -want log values, +got log values:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
 func asModernManaged(obj client.Object, generation int64) *fake.ModernManaged {
 	mg := obj.(*fake.ModernManaged)
 	mg.Generation = generation